@@ -0,0 +1,96 @@
+package future
+
+import "context"
+
+// Then runs fn on the result of f once f completes, producing a new
+// FutureTask[U]. If f fails, the error is propagated without calling fn.
+// Cancelling the returned future only stops waiting on it; f is not shared
+// exclusively by this call, so it is left running for any other caller
+// still holding a reference to it.
+func Then[T, U any](f *FutureTask[T], fn func(T) (U, error)) *FutureTask[U] {
+	return NewFutureWithContext(context.Background(), func(ctx context.Context) Result[U] {
+		result := f.Get(ctx)
+		if result.Error != nil {
+			return Result[U]{Error: result.Error}
+		}
+		value, err := fn(result.Value)
+		return Result[U]{Value: value, Error: err}
+	})
+}
+
+// Map is an alias for Then, named for the common case where fn cannot fail.
+func Map[T, U any](f *FutureTask[T], fn func(T) U) *FutureTask[U] {
+	return Then(f, func(value T) (U, error) {
+		return fn(value), nil
+	})
+}
+
+// Catch runs fn when f fails, letting the caller recover from the error and
+// produce a replacement value. If f succeeds, its result passes through
+// untouched. Cancelling the returned future only stops waiting on it; f
+// keeps running, as it may still be shared with other callers.
+func Catch[T any](f *FutureTask[T], fn func(error) (T, error)) *FutureTask[T] {
+	return NewFutureWithContext(context.Background(), func(ctx context.Context) Result[T] {
+		result := f.Get(ctx)
+		if result.Error == nil {
+			return result
+		}
+		value, err := fn(result.Error)
+		return Result[T]{Value: value, Error: err}
+	})
+}
+
+// All waits for every future to complete and returns their values in order.
+// It fails with the first error encountered. Cancelling the returned future
+// only stops waiting on the remaining futures; none of futures is cancelled,
+// since each may still be shared with other callers.
+func All[T any](futures ...*FutureTask[T]) *FutureTask[[]T] {
+	return NewFutureWithContext(context.Background(), func(ctx context.Context) Result[[]T] {
+		values := make([]T, len(futures))
+		for i, f := range futures {
+			result := f.Get(ctx)
+			if result.Error != nil {
+				return Result[[]T]{Error: result.Error}
+			}
+			values[i] = result.Value
+		}
+		return Result[[]T]{Value: values}
+	})
+}
+
+// Any returns the result of whichever future settles first, successfully or
+// not - matching the JS Promise.race convention rather than Promise.any.
+// See Race for the first-success variant.
+func Any[T any](futures ...*FutureTask[T]) *FutureTask[T] {
+	return race(futures, false)
+}
+
+// Race returns the result of whichever future completes first with a
+// successful value, ignoring failures unless every future fails - matching
+// the JS Promise.any convention rather than Promise.race. See Any for the
+// first-to-settle variant.
+func Race[T any](futures ...*FutureTask[T]) *FutureTask[T] {
+	return race(futures, true)
+}
+
+func race[T any](futures []*FutureTask[T], skipErrors bool) *FutureTask[T] {
+	return NewFutureWithContext(context.Background(), func(ctx context.Context) Result[T] {
+		resultChannel := make(chan Result[T], len(futures))
+		for _, f := range futures {
+			f := f
+			go func() {
+				resultChannel <- f.Get(ctx)
+			}()
+		}
+		var lastErr error
+		for range futures {
+			result := <-resultChannel
+			if result.Error == nil || !skipErrors {
+				return result
+			}
+			lastErr = result.Error
+		}
+		var zero T
+		return Result[T]{Value: zero, Error: lastErr}
+	})
+}
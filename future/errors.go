@@ -0,0 +1,72 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+)
+
+// Sentinel errors every future-specific error wraps, so callers can branch on
+// cause with errors.Is instead of matching error strings or concrete types.
+var (
+	// ErrTimeout indicates a Get/GetWithDeadline call's own wait context
+	// expired, or the future's task context hit its deadline.
+	ErrTimeout = errors.New("future: operation timed out")
+	// ErrCancelled indicates the future was cancelled via Cancel, or via
+	// CancelCause without a custom cause. CancelCause(cause) with a custom
+	// cause surfaces exactly that cause instead, so it only matches
+	// ErrCancelled if the caller's own error wraps it.
+	ErrCancelled = errors.New("future: cancelled")
+	// ErrTaskPanic indicates the task function panicked instead of returning.
+	ErrTaskPanic = errors.New("future: task panicked")
+)
+
+// TimeoutError is returned when a future's deadline elapses before the task completes.
+type TimeoutError struct {
+	errorString string
+}
+
+func (e *TimeoutError) Error() string {
+	return e.errorString
+}
+
+// Unwrap lets errors.Is/As match TimeoutError against both ErrTimeout and
+// the stdlib context.DeadlineExceeded it was raised from.
+func (e *TimeoutError) Unwrap() []error {
+	return []error{ErrTimeout, context.DeadlineExceeded}
+}
+
+// InterruptError is returned when a future is cancelled before it completes.
+type InterruptError struct {
+	errorString string
+}
+
+func (e *InterruptError) Error() string {
+	return e.errorString
+}
+
+// Unwrap lets errors.Is/As match InterruptError against both ErrCancelled and
+// the stdlib context.Canceled it was raised from.
+func (e *InterruptError) Unwrap() []error {
+	return []error{ErrCancelled, context.Canceled}
+}
+
+// taskPanicError wraps a recovered task panic, keeping the stack trace captured
+// at the moment of the panic so it isn't lost the way an uncaught panic would be.
+type taskPanicError struct {
+	recovered any
+	stack     []byte
+}
+
+func newTaskPanicError(recovered any) error {
+	return &taskPanicError{recovered: recovered, stack: debug.Stack()}
+}
+
+func (e *taskPanicError) Error() string {
+	return fmt.Sprintf("future: task panicked: %v\n%s", e.recovered, e.stack)
+}
+
+func (e *taskPanicError) Unwrap() error {
+	return ErrTaskPanic
+}
@@ -0,0 +1,40 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestReturnAFutureRecoversPanic(t *testing.T) {
+	f := ReturnAFuture(func() (string, error) {
+		panic("boom")
+	})
+	result := f.Get(context.Background())
+	if !errors.Is(result.Error, ErrTaskPanic) {
+		t.Fatalf("expected ErrTaskPanic, got %v", result.Error)
+	}
+	if f.State() != Failed {
+		t.Fatalf("expected Failed, got %v", f.State())
+	}
+}
+
+func TestTimeoutErrorMatchesStdlibDeadlineExceeded(t *testing.T) {
+	err := &TimeoutError{errorString: "Request Timeout!"}
+	if !errors.Is(err, ErrTimeout) {
+		t.Fatalf("expected TimeoutError to match ErrTimeout")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected TimeoutError to match context.DeadlineExceeded")
+	}
+}
+
+func TestInterruptErrorMatchesStdlibCanceled(t *testing.T) {
+	err := &InterruptError{errorString: "Cancelled Manually"}
+	if !errors.Is(err, ErrCancelled) {
+		t.Fatalf("expected InterruptError to match ErrCancelled")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected InterruptError to match context.Canceled")
+	}
+}
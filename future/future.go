@@ -0,0 +1,228 @@
+// Package future provides a generic, typed Future/Promise primitive for
+// running a task on a goroutine and retrieving its result synchronously,
+// optionally with a timeout or cancellation.
+package future
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Future describes a value of type T that will become available at some
+// point in the future, produced by a task running on its own goroutine.
+type Future[T any] interface {
+	Get(ctx context.Context) Result[T]
+	GetWithDeadline(ctx context.Context, deadline time.Time) Result[T]
+	Cancel() bool
+	CancelCause(cause error) bool
+	IsCancelled() bool
+	IsRunning() bool
+	Complete() bool
+	State() FutureState
+}
+
+// FutureTask is the Future implementation returned by ReturnAFuture and
+// NewFutureWithContext. Its lifecycle is driven entirely by taskCtx: the
+// task observes taskCtx.Done() to abort early, and callers observe it to
+// learn why the future was cancelled via context.Cause. state is the single
+// source of truth for the future's lifecycle, advanced with one CAS per
+// transition so isRunning/Complete/IsCancelled never race with the task
+// goroutine.
+type FutureTask[T any] struct {
+	taskCtx    context.Context
+	cancelTask context.CancelCauseFunc
+	channel    <-chan Result[T]
+	resultPtr  atomic.Pointer[Result[T]]
+	state      atomic.Int32
+
+	flags           FutureFlag
+	cancelCallback  func(*FutureTask[T])
+	cancelRequested atomic.Bool
+	pendingCause    error
+
+	callbackMethod func()
+}
+
+// Result holds the typed value produced by a task, or the error it failed with.
+type Result[T any] struct {
+	Value T
+	Error error
+}
+
+// String implements fmt.Stringer for Result.
+func (result Result[T]) String() string {
+	err := "no"
+	if result.Error != nil {
+		err = result.Error.Error()
+	}
+	return fmt.Sprintf("%v with (%s error)", result.Value, err)
+}
+
+// NewFutureWithContext starts task on its own goroutine, threading a
+// derived, cancellable context through to it so the task itself can observe
+// cancellation and abort mid-flight instead of running to completion
+// unattended.
+func NewFutureWithContext[T any](ctx context.Context, task func(ctx context.Context) Result[T], opts ...Option[T]) *FutureTask[T] {
+	taskCtx, cancel := context.WithCancelCause(ctx)
+	resultChannel := make(chan Result[T], 1)
+	futureObject := &FutureTask[T]{
+		taskCtx:    taskCtx,
+		cancelTask: cancel,
+		channel:    resultChannel,
+	}
+	for _, opt := range opts {
+		opt(futureObject)
+	}
+	go func() {
+		futureObject.state.CompareAndSwap(int32(Pending), int32(Running))
+		result := task(taskCtx)
+		target := Completed
+		if result.Error != nil {
+			target = Failed
+		}
+		futureObject.commitResult(result, target)
+		// Release taskCtx now that the task has finished on its own;
+		// otherwise a future built on a cancelable parent ctx stays
+		// registered on that parent's child list until the parent itself is
+		// cancelled. A no-op if ForceCancel already cancelled taskCtx.
+		futureObject.cancelTask(nil)
+		resultChannel <- result
+	}()
+	return futureObject
+}
+
+// ReturnAFuture starts task on its own goroutine and returns a FutureTask
+// that will yield its result. The task itself has no access to the future's
+// context; use NewFutureWithContext when the task needs to observe cancellation.
+// A panic inside task is recovered and surfaced as an ErrTaskPanic instead of
+// crashing the program.
+func ReturnAFuture[T any](task func() (T, error)) *FutureTask[T] {
+	return NewFutureWithContext(context.Background(), func(ctx context.Context) (result Result[T]) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				result = Result[T]{Error: newTaskPanicError(recovered)}
+			}
+		}()
+		value, err := task()
+		return Result[T]{Value: value, Error: err}
+	})
+}
+
+// Get blocks until the task completes, ctx is done, or the future is
+// cancelled, whichever happens first.
+func (futureTask *FutureTask[T]) Get(ctx context.Context) Result[T] {
+	if result := futureTask.resultPtr.Load(); result != nil {
+		return *result
+	}
+	if futureTask.callbackMethod != nil {
+		defer futureTask.callbackMethod()
+	}
+	select {
+	case <-ctx.Done():
+		// Only this caller's wait expired; the future itself may still be
+		// running for other callers, so its state is left untouched.
+		err := ctx.Err()
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = &TimeoutError{errorString: "Request Timeout!"}
+		}
+		return Result[T]{Error: err}
+	case <-futureTask.taskCtx.Done():
+		cause := context.Cause(futureTask.taskCtx)
+		if errors.Is(cause, context.DeadlineExceeded) {
+			cause = &TimeoutError{errorString: "Request Timeout!"}
+		}
+		futureTask.commitResult(Result[T]{Error: cause}, Cancelled)
+		return *futureTask.resultPtr.Load()
+	case result := <-futureTask.channel:
+		return result
+	}
+}
+
+// GetWithDeadline blocks until the task completes or deadline elapses.
+func (futureTask *FutureTask[T]) GetWithDeadline(ctx context.Context, deadline time.Time) Result[T] {
+	deadlineCtx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+	return futureTask.Get(deadlineCtx)
+}
+
+// commitResult finalizes the future with a single CAS on resultPtr: whichever
+// caller's result is installed first wins, and every later attempt - from the
+// task goroutine finishing naturally, from Get observing taskCtx.Done(), or
+// from ForceCancel - is a harmless no-op. Because the CAS installs a fully
+// formed *Result[T], losers are guaranteed to see the winner's value with no
+// separate publish step to get wrong.
+func (futureTask *FutureTask[T]) commitResult(result Result[T], target FutureState) bool {
+	if !futureTask.resultPtr.CompareAndSwap(nil, &result) {
+		return false
+	}
+	futureTask.state.Store(int32(target))
+	return true
+}
+
+// State reports the future's current lifecycle state.
+func (futureTask *FutureTask[T]) State() FutureState {
+	return FutureState(futureTask.state.Load())
+}
+
+// IsCancelled reports whether the future was cancelled via Cancel or CancelCause.
+func (futureTask *FutureTask[T]) IsCancelled() bool {
+	return futureTask.State() == Cancelled
+}
+
+// Complete reports whether the future has finished, one way or another.
+func (futureTask *FutureTask[T]) Complete() bool {
+	return futureTask.State().terminal()
+}
+
+// Cancel cancels the future with the default InterruptError cause.
+func (futureTask *FutureTask[T]) Cancel() bool {
+	return futureTask.CancelCause(&InterruptError{errorString: "Cancelled Manually"})
+}
+
+// CancelCause requests cancellation of the future, recording cause as the
+// reason. Ordinarily this finalizes immediately via ForceCancel. If the
+// future was constructed WithFlags(OwnCancelSchedule) and has a
+// CancelCallback, that callback runs instead and decides how - and when -
+// to finalize by calling ForceCancel itself.
+func (futureTask *FutureTask[T]) CancelCause(cause error) bool {
+	if !futureTask.cancelRequested.CompareAndSwap(false, true) {
+		return false
+	}
+	futureTask.pendingCause = cause
+	if futureTask.flags&OwnCancelSchedule != 0 && futureTask.cancelCallback != nil {
+		futureTask.cancelCallback(futureTask)
+		return true
+	}
+	return futureTask.ForceCancel(cause)
+}
+
+// PendingCause returns the cause passed to the CancelCause call that
+// triggered the current CancelCallback, if any.
+func (futureTask *FutureTask[T]) PendingCause() error {
+	return futureTask.pendingCause
+}
+
+// ForceCancel finalizes cancellation with cause: it transitions the future
+// to Cancelled and cancels its task context so taskCtx.Done() fires for the
+// task and for any callers blocked in Get. It is a no-op once the future has
+// already reached a terminal state.
+func (futureTask *FutureTask[T]) ForceCancel(cause error) bool {
+	if !futureTask.commitResult(Result[T]{Error: cause}, Cancelled) {
+		return false
+	}
+	futureTask.cancelTask(cause)
+	return true
+}
+
+// IsRunning reports whether the task is currently executing.
+func (futureTask *FutureTask[T]) IsRunning() bool {
+	return futureTask.State() == Running
+}
+
+// AddDoneCallback registers a callback to run whenever the result is consumed.
+func (futureTask *FutureTask[T]) AddDoneCallback(callbackMethod func()) {
+	futureTask.callbackMethod = callbackMethod
+}
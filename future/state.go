@@ -0,0 +1,72 @@
+package future
+
+// FutureState is the explicit lifecycle of a FutureTask, replacing the
+// ad-hoc success/running/done booleans the type used to track.
+type FutureState int32
+
+const (
+	// Pending is the state of a future before its task has started running.
+	Pending FutureState = iota
+	// Running is the state while the task goroutine is executing.
+	Running
+	// Completed is the terminal state for a task that returned successfully.
+	Completed
+	// Cancelled is the terminal state for a future whose cancellation won the race.
+	Cancelled
+	// Failed is the terminal state for a task that returned an error.
+	Failed
+)
+
+// String implements fmt.Stringer for FutureState.
+func (s FutureState) String() string {
+	switch s {
+	case Pending:
+		return "Pending"
+	case Running:
+		return "Running"
+	case Completed:
+		return "Completed"
+	case Cancelled:
+		return "Cancelled"
+	case Failed:
+		return "Failed"
+	default:
+		return "Unknown"
+	}
+}
+
+func (s FutureState) terminal() bool {
+	return s == Completed || s == Cancelled || s == Failed
+}
+
+// FutureFlag is a bitset of optional behaviors for a FutureTask.
+type FutureFlag uint8
+
+const (
+	// OwnCancelSchedule tells a FutureTask not to transition to Cancelled on
+	// its own when CancelCause is called. Instead, the future's
+	// CancelCallback runs and decides how to unwind - typically by forwarding
+	// cancellation to an underlying resource (an HTTP request, a DB query)
+	// and only calling ForceCancel once that resource has actually stopped.
+	OwnCancelSchedule FutureFlag = 1 << iota
+)
+
+// Option configures a FutureTask at construction time. See WithFlags and
+// WithCancelCallback.
+type Option[T any] func(*FutureTask[T])
+
+// WithFlags sets the FutureFlag bitset on a FutureTask.
+func WithFlags[T any](flags FutureFlag) Option[T] {
+	return func(futureTask *FutureTask[T]) {
+		futureTask.flags = flags
+	}
+}
+
+// WithCancelCallback registers the callback invoked when CancelCause is
+// called on a future with the OwnCancelSchedule flag set. The callback is
+// responsible for eventually calling ForceCancel to finalize cancellation.
+func WithCancelCallback[T any](cancelCallback func(*FutureTask[T])) Option[T] {
+	return func(futureTask *FutureTask[T]) {
+		futureTask.cancelCallback = cancelCallback
+	}
+}
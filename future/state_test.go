@@ -0,0 +1,99 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFutureTaskStateTransitionsToCompleted(t *testing.T) {
+	f := ReturnAFuture(func() (int, error) {
+		return 42, nil
+	})
+	result := f.Get(context.Background())
+	if result.Error != nil || result.Value != 42 {
+		t.Fatalf("unexpected result: %v", result)
+	}
+	if f.State() != Completed {
+		t.Fatalf("expected Completed, got %v", f.State())
+	}
+	if !f.Complete() || f.IsCancelled() || f.IsRunning() {
+		t.Fatalf("unexpected state flags: %v", f.State())
+	}
+}
+
+func TestFutureTaskStateTransitionsToFailed(t *testing.T) {
+	errBoom := errors.New("boom")
+	f := ReturnAFuture(func() (int, error) {
+		return 0, errBoom
+	})
+	result := f.Get(context.Background())
+	if !errors.Is(result.Error, errBoom) {
+		t.Fatalf("expected errBoom, got %v", result.Error)
+	}
+	if f.State() != Failed {
+		t.Fatalf("expected Failed, got %v", f.State())
+	}
+}
+
+func TestFutureTaskStateTransitionsToCancelled(t *testing.T) {
+	f := NewFutureWithContext(context.Background(), func(taskCtx context.Context) Result[string] {
+		<-taskCtx.Done()
+		return Result[string]{Error: context.Cause(taskCtx)}
+	})
+	if ok := f.Cancel(); !ok {
+		t.Fatalf("expected first Cancel to succeed")
+	}
+	if ok := f.Cancel(); ok {
+		t.Fatalf("expected second Cancel to be a no-op")
+	}
+	result := f.Get(context.Background())
+	if result.Error == nil {
+		t.Fatalf("expected a cancellation error")
+	}
+	if f.State() != Cancelled || !f.IsCancelled() {
+		t.Fatalf("expected Cancelled, got %v", f.State())
+	}
+}
+
+// TestCommitResultWinnerTakesAll drives the race between the task goroutine
+// finishing naturally and Get observing taskCtx.Done() at (nearly) the same
+// time. Whichever commitResult call wins the CAS must fully determine the
+// result every caller and the state both observe - there is no window where
+// State() reports one outcome and Get() returns another.
+func TestCommitResultWinnerTakesAll(t *testing.T) {
+	for i := 0; i < 200; i++ {
+		f := NewFutureWithContext(context.Background(), func(taskCtx context.Context) Result[int] {
+			return Result[int]{Value: 7}
+		})
+		go f.Cancel()
+
+		result := f.Get(context.Background())
+		state := f.State()
+		switch state {
+		case Completed:
+			if result.Error != nil || result.Value != 7 {
+				t.Fatalf("Completed state but mismatched result: %v", result)
+			}
+		case Cancelled:
+			if result.Error == nil {
+				t.Fatalf("Cancelled state but no error in result: %v", result)
+			}
+		default:
+			t.Fatalf("unexpected terminal state: %v", state)
+		}
+	}
+}
+
+func TestGetWithDeadlinePastDeadline(t *testing.T) {
+	f := ReturnAFuture(func() (int, error) {
+		time.Sleep(50 * time.Millisecond)
+		return 1, nil
+	})
+	result := f.GetWithDeadline(context.Background(), time.Now().Add(-time.Millisecond))
+	var timeoutErr *TimeoutError
+	if !errors.As(result.Error, &timeoutErr) {
+		t.Fatalf("expected *TimeoutError, got %v", result.Error)
+	}
+}
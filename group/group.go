@@ -0,0 +1,191 @@
+// Package group provides FutureGroup, a singleflight-style primitive that
+// deduplicates concurrent Futures sharing the same key, built on top of the
+// future package.
+package group
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ShashantNagpure/futureInGolang/future"
+)
+
+// errRetry marks a call that was torn down because its last waiter cancelled
+// it. A Waiter that observes errRetry re-enters the group transparently
+// instead of surfacing it, so a caller that is still interested (because it
+// shares a Waiter with whoever cancelled, or raced the teardown) gets a
+// fresh run rather than a spurious cancellation.
+var errRetry = errors.New("group: retry")
+
+// call is the in-flight (or just-finished) execution shared by every waiter
+// on a given key.
+type call[T any] struct {
+	once      sync.Once
+	ready     chan struct{}
+	result    future.Result[T]
+	waiters   int
+	startedAt time.Time
+}
+
+func (c *call[T]) resolve(result future.Result[T]) {
+	c.once.Do(func() {
+		c.result = result
+		close(c.ready)
+	})
+}
+
+// FutureGroup deduplicates concurrent work by key: if a task for a key is
+// already in flight, Do hands out another waiter on it instead of starting
+// a duplicate task.
+type FutureGroup[T any] struct {
+	mu    sync.Mutex
+	calls map[string]*call[T]
+}
+
+// NewFutureGroup creates an empty FutureGroup.
+func NewFutureGroup[T any]() *FutureGroup[T] {
+	return &FutureGroup[T]{calls: make(map[string]*call[T])}
+}
+
+// Do returns a Waiter on the task registered for key. If a task for key is
+// already running, the returned Waiter shares that in-flight call instead of
+// starting a new one.
+func (g *FutureGroup[T]) Do(key string, task func() future.Result[T]) *Waiter[T] {
+	g.mu.Lock()
+	c, ok := g.calls[key]
+	if !ok {
+		c = g.startCall(key, task)
+	}
+	c.waiters++
+	g.mu.Unlock()
+	return &Waiter[T]{group: g, key: key, call: c, task: task}
+}
+
+// DoChan is Do, reported over a channel instead of a blocking Get call.
+func (g *FutureGroup[T]) DoChan(key string, task func() future.Result[T]) <-chan future.Result[T] {
+	waiter := g.Do(key, task)
+	out := make(chan future.Result[T], 1)
+	go func() {
+		out <- waiter.Get(context.Background())
+		close(out)
+	}()
+	return out
+}
+
+// startCall must be called with g.mu held.
+func (g *FutureGroup[T]) startCall(key string, task func() future.Result[T]) *call[T] {
+	c := &call[T]{ready: make(chan struct{}), startedAt: time.Now()}
+	g.calls[key] = c
+	go func() {
+		c.resolve(task())
+		g.mu.Lock()
+		if g.calls[key] == c {
+			delete(g.calls, key)
+		}
+		g.mu.Unlock()
+	}()
+	return c
+}
+
+// detach removes one waiter from the call registered for key. When the last
+// waiter detaches before the task has produced a result, the call is evicted
+// and resolved with errRetry rather than left to complete unobserved.
+func (g *FutureGroup[T]) detach(key string, c *call[T]) {
+	g.mu.Lock()
+	c.waiters--
+	remaining := c.waiters
+	if remaining > 0 {
+		g.mu.Unlock()
+		return
+	}
+	if g.calls[key] == c {
+		delete(g.calls, key)
+	}
+	g.mu.Unlock()
+	c.resolve(future.Result[T]{Error: errRetry})
+}
+
+// KeyStats reports the state of one in-flight key.
+type KeyStats struct {
+	Waiters  int
+	InFlight time.Duration
+}
+
+// Stats reports per-key waiter counts and in-flight duration for every key
+// currently registered with the group.
+func (g *FutureGroup[T]) Stats() map[string]KeyStats {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	stats := make(map[string]KeyStats, len(g.calls))
+	for key, c := range g.calls {
+		stats[key] = KeyStats{Waiters: c.waiters, InFlight: time.Since(c.startedAt)}
+	}
+	return stats
+}
+
+// Waiter is one caller's handle on a FutureGroup call. Cancelling a Waiter
+// only detaches that waiter; the underlying task keeps running for any
+// other waiter still attached to the same key.
+type Waiter[T any] struct {
+	group *FutureGroup[T]
+	key   string
+	task  func() future.Result[T]
+
+	mu       sync.Mutex
+	call     *call[T]
+	detached bool
+}
+
+// Get blocks until the shared call resolves, transparently re-entering the
+// group if the call was torn down by another waiter's Cancel before it had
+// a real result. If ctx is done first, Get detaches this waiter just as
+// Cancel would, so a caller that stops waiting doesn't keep the underlying
+// task alive on its behalf.
+func (w *Waiter[T]) Get(ctx context.Context) future.Result[T] {
+	for {
+		w.mu.Lock()
+		c := w.call
+		w.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			w.detachOnce()
+			return future.Result[T]{Error: ctx.Err()}
+		case <-c.ready:
+		}
+
+		if errors.Is(c.result.Error, errRetry) {
+			w.mu.Lock()
+			w.call = w.group.Do(w.key, w.task).call
+			w.mu.Unlock()
+			continue
+		}
+		return c.result
+	}
+}
+
+// Cancel detaches this waiter from its call. The underlying task is only
+// actually torn down once every waiter has detached.
+func (w *Waiter[T]) Cancel() bool {
+	return w.detachOnce()
+}
+
+// detachOnce detaches this waiter from its current call, exactly once,
+// whether it's reached through Cancel or through Get observing its ctx
+// done. Later calls are no-ops so a waiter that is both cancelled and
+// ctx-cancelled doesn't double-decrement the call's waiter count.
+func (w *Waiter[T]) detachOnce() bool {
+	w.mu.Lock()
+	if w.detached {
+		w.mu.Unlock()
+		return false
+	}
+	w.detached = true
+	c := w.call
+	w.mu.Unlock()
+
+	w.group.detach(w.key, c)
+	return true
+}
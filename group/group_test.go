@@ -0,0 +1,129 @@
+package group
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ShashantNagpure/futureInGolang/future"
+)
+
+func TestFutureGroupDeduplicatesConcurrentCallers(t *testing.T) {
+	var calls int32
+	task := func() future.Result[string] {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(50 * time.Millisecond)
+		return future.Result[string]{Value: "fetched"}
+	}
+
+	g := NewFutureGroup[string]()
+	var wg sync.WaitGroup
+	results := make([]future.Result[string], 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = g.Do("user:1", task).Get(context.Background())
+		}(i)
+	}
+	wg.Wait()
+
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Fatalf("expected task to run once, ran %d times", calls)
+	}
+	for i, result := range results {
+		if result.Error != nil || result.Value != "fetched" {
+			t.Fatalf("waiter %d got unexpected result: %v", i, result)
+		}
+	}
+}
+
+func TestWaiterCancelOnlyTearsDownAfterLastWaiter(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	task := func() future.Result[string] {
+		close(started)
+		<-release
+		return future.Result[string]{Value: "done"}
+	}
+
+	g := NewFutureGroup[string]()
+	waiterA := g.Do("key", task)
+	waiterB := g.Do("key", task)
+	<-started
+
+	if ok := waiterA.Cancel(); !ok {
+		t.Fatalf("expected first Cancel to succeed")
+	}
+	if stats := g.Stats(); stats["key"].Waiters != 1 {
+		t.Fatalf("expected 1 remaining waiter, got %+v", stats["key"])
+	}
+
+	close(release)
+	result := waiterB.Get(context.Background())
+	if result.Error != nil || result.Value != "done" {
+		t.Fatalf("expected surviving waiter to observe the real result, got %v", result)
+	}
+}
+
+func TestWaiterGetRetriesAfterLastWaiterCancels(t *testing.T) {
+	var calls int32
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	task := func() future.Result[string] {
+		n := atomic.AddInt32(&calls, 1)
+		started <- struct{}{}
+		if n == 1 {
+			<-release
+		}
+		return future.Result[string]{Value: "done"}
+	}
+
+	g := NewFutureGroup[string]()
+	waiter := g.Do("key", task)
+	<-started
+
+	// Detaching the only waiter while the task is still in flight evicts
+	// the call and resolves it with errRetry; Get must transparently start
+	// a fresh call instead of surfacing errRetry to the caller.
+	if ok := waiter.Cancel(); !ok {
+		t.Fatalf("expected Cancel to succeed")
+	}
+	close(release)
+
+	result := waiter.Get(context.Background())
+	if result.Error != nil || result.Value != "done" {
+		t.Fatalf("expected Get to retry past errRetry and return a real result, got %v", result)
+	}
+	if atomic.LoadInt32(&calls) < 2 {
+		t.Fatalf("expected task to run again after retry, ran %d times", calls)
+	}
+}
+
+func TestWaiterGetDetachesWhenCtxDone(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	task := func() future.Result[string] {
+		close(started)
+		<-release
+		return future.Result[string]{Value: "done"}
+	}
+
+	g := NewFutureGroup[string]()
+	waiter := g.Do("key", task)
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result := waiter.Get(ctx)
+	if result.Error == nil {
+		t.Fatalf("expected ctx cancellation error, got %v", result)
+	}
+
+	if stats := g.Stats(); stats["key"].Waiters != 0 {
+		t.Fatalf("expected ctx-done Get to detach the waiter, got %+v", stats["key"])
+	}
+	close(release)
+}
@@ -2,221 +2,140 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
-)
-
-//Future methods
-type Future interface {
-	get() Result
-	getWithTimeout(duration time.Duration) Result
-	cancel() bool
-	isCancelled() bool
-	isRunning() bool
-	complete() bool
-}
-
-// FutureTask Future object
-type FutureTask struct {
-	success        bool
-	error          error
-	running        bool
-	done           bool
-	result         Result
-	channel        <-chan Result
-	callbackMethod func()
-}
 
-// Result holds the result of callable
-type Result struct {
-	value interface{}
-	error error
-}
+	"github.com/ShashantNagpure/futureInGolang/future"
+	"github.com/ShashantNagpure/futureInGolang/group"
+	"github.com/ShashantNagpure/futureInGolang/pipeline"
+)
 
-func (futureTask *FutureTask) get() Result {
-	if futureTask.done {
-		return futureTask.result
-	}
-	if futureTask.callbackMethod != nil {
-		defer futureTask.callbackMethod()
-	}
+func main() {
 	ctx := context.Background()
-	return futureTask.getWithContext(ctx)
-}
-
-func (futureTask *FutureTask) getWithTimeout(timeout time.Duration) Result {
-	if futureTask.done {
-		return futureTask.result
-	}
-	if futureTask.callbackMethod != nil {
-		defer futureTask.callbackMethod()
-	}
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
-	return futureTask.getWithContext(ctx)
-}
-
-func (futureTask *FutureTask) getWithContext(ctx context.Context) Result {
-	fmt.Println("Executing getContext to receive from channel")
-	select {
-	case <-ctx.Done():
-		futureTask.done = true
-		futureTask.success = false
-		futureTask.error = &TimeoutError{errorString: "Request Timeout!"}
-		futureTask.result = Result{value: nil, error: futureTask.error}
-		return futureTask.result
-
-	case futureTask.result = <-futureTask.channel:
-		if futureTask.result.error != nil {
-			futureTask.done = true
-			futureTask.success = false
-			futureTask.error = futureTask.result.error
-		} else {
-			futureTask.success = true
-			futureTask.done = true
-			futureTask.error = nil
-		}
-		return futureTask.result
-	}
-}
-
-func (futureTask *FutureTask) isCancelled() bool {
-	if futureTask.done {
-		if futureTask.error != nil && futureTask.error.Error() == "Cancelled Manually" {
-			return true
-		}
-	}
-	return false
-}
-
-func (futureTask *FutureTask) complete() bool {
-	if futureTask.done {
-		return true
-	}
-	return false
-}
-
-func (futureTask *FutureTask) cancel() bool {
-	if futureTask.complete() || futureTask.isCancelled() || futureTask.isRunning() {
-		return false
-	}
-	if futureTask.callbackMethod != nil {
-		defer futureTask.callbackMethod()
-	}
-	interruptionError := &InterruptError{errorString: "Cancelled Manually"}
-	futureTask.done = true
-	futureTask.success = false
-	futureTask.error = interruptionError
-	futureTask.result = Result{value: nil, error: interruptionError}
-	return true
-}
-
-func (futureTask *FutureTask) isRunning() bool {
-	if futureTask.running {
-		return true
-	}
-	return false
-}
 
-//Stringer method for result
-func (result Result) String() string {
-	err := "no"
-	if result.error != nil {
-		err = result.error.Error()
-	}
-	return fmt.Sprintf("%v with (%s error)", result.value, err)
-}
-func (futureTask *FutureTask) addDoneCallback(callbackMethod func()) {
-	futureTask.callbackMethod = callbackMethod
-}
-
-//ReturnAFuture creates a new future for task func
-func ReturnAFuture(task func() Result) *FutureTask {
-	channelForExecution := make(chan Result)
-	futureObject := FutureTask{
-		success: false,
-		done:    false,
-		error:   nil,
-		result:  Result{},
-		channel: channelForExecution,
-	}
-	go func() {
-		defer func() {
-			close(channelForExecution)
-			futureObject.running = false
-		}()
-		futureObject.running = true
-		resultObject := task()
-		channelForExecution <- resultObject
-	}()
-	return &futureObject
-}
-
-func (e *TimeoutError) Error() string {
-	return e.errorString
-}
-func (e *InterruptError) Error() string {
-	return e.errorString
-}
-
-//TimeoutError class
-type TimeoutError struct {
-	errorString string
-}
-
-//InterruptError class
-type InterruptError struct {
-	errorString string
-}
-
-func main() {
 	//simple example of future
-	futureInstance2 := ReturnAFuture(func() Result {
-		var res interface{}
-		res = "40"
+	futureInstance2 := future.ReturnAFuture(func() (string, error) {
 		time.Sleep(4 * time.Second)
-		return Result{value: res}
+		return "40", nil
 	})
-	f2 := futureInstance2.get()
+	f2 := futureInstance2.Get(ctx)
 	fmt.Println(f2)
 	fmt.Println("------------")
 
 	//example of timeout error
-	futureInstance1 := ReturnAFuture(func() Result {
-		var res interface{}
-		res = 30 + 23
+	futureInstance1 := future.ReturnAFuture(func() (int, error) {
 		time.Sleep(2 * time.Second)
-		return Result{value: res}
+		return 30 + 23, nil
 	})
-	f1 := futureInstance1.getWithTimeout(1 * time.Second)
+	f1 := futureInstance1.GetWithDeadline(ctx, time.Now().Add(1*time.Second))
 	fmt.Println(f1)
 	fmt.Println("------------")
 
 	//example of cancel operation
-	futureInstance3 := ReturnAFuture(func() Result {
-		var res interface{}
-		res = "50"
+	futureInstance3 := future.ReturnAFuture(func() (string, error) {
 		time.Sleep(20 * time.Second)
-		return Result{value: res}
+		return "50", nil
 	})
-	ok := futureInstance3.cancel()
+	ok := futureInstance3.Cancel()
 	fmt.Println("Cancel operation:", ok)
-	f3 := futureInstance3.get()
+	f3 := futureInstance3.Get(ctx)
 	fmt.Println(f3)
 	fmt.Println("------------")
 
 	//example of callback
-	futureInstance4 := ReturnAFuture(func() Result {
-		var res interface{}
-		res = "50"
+	futureInstance4 := future.ReturnAFuture(func() (string, error) {
 		time.Sleep(2 * time.Second)
-		return Result{value: res}
+		return "50", nil
 	})
-	futureInstance4.addDoneCallback(func() {
+	futureInstance4.AddDoneCallback(func() {
 		fmt.Println("Executing callback function")
 	})
-	f4 := futureInstance4.get()
+	f4 := futureInstance4.Get(ctx)
 	fmt.Println(f4)
 	fmt.Println("------------")
 
+	//example of composition: Then chains a transformation onto a future's result
+	futureInstance5 := future.ReturnAFuture(func() (int, error) {
+		return 21, nil
+	})
+	doubled := future.Then(futureInstance5, func(value int) (int, error) {
+		return value * 2, nil
+	})
+	fmt.Println(doubled.Get(ctx))
+	fmt.Println("------------")
+
+	//example of a task that observes cancellation through its own context
+	futureInstance6 := future.NewFutureWithContext(context.Background(), func(taskCtx context.Context) future.Result[string] {
+		select {
+		case <-time.After(20 * time.Second):
+			return future.Result[string]{Value: "too late"}
+		case <-taskCtx.Done():
+			return future.Result[string]{Error: context.Cause(taskCtx)}
+		}
+	})
+	futureInstance6.CancelCause(fmt.Errorf("no longer needed"))
+	fmt.Println(futureInstance6.Get(ctx))
+	fmt.Println("------------")
+
+	//example of a pipeline: square each number, then format it as a string
+	square := pipeline.Lift(func(n int) (int, error) {
+		return n * n, nil
+	})
+	format := pipeline.Lift(func(n int) (string, error) {
+		return fmt.Sprintf("value=%d", n), nil
+	})
+	numbers := make(chan int, 3)
+	numbers <- 1
+	numbers <- 2
+	numbers <- 3
+	close(numbers)
+
+	chain := pipeline.Add(pipeline.Add(pipeline.New[int](), square), format)
+	pipelineResult := chain.Run(ctx, numbers).Get(ctx)
+	fmt.Println(pipelineResult)
+	fmt.Println("------------")
+
+	//example of a FutureGroup: two concurrent callers for the same key share one task
+	futureGroup := group.NewFutureGroup[string]()
+	task := func() future.Result[string] {
+		time.Sleep(1 * time.Second)
+		return future.Result[string]{Value: "fetched"}
+	}
+	waiterA := futureGroup.Do("user:1", task)
+	waiterB := futureGroup.Do("user:1", task)
+	fmt.Println(waiterA.Get(ctx))
+	fmt.Println(waiterB.Get(ctx))
+	fmt.Println("------------")
+
+	//example of OwnCancelSchedule: cancellation is forwarded to a fake
+	//"resource" (here just a sleep) before the future actually finalizes
+	var futureInstance7 *future.FutureTask[string]
+	futureInstance7 = future.NewFutureWithContext(context.Background(),
+		func(taskCtx context.Context) future.Result[string] {
+			<-taskCtx.Done()
+			return future.Result[string]{Error: context.Cause(taskCtx)}
+		},
+		future.WithFlags[string](future.OwnCancelSchedule),
+		future.WithCancelCallback(func(f *future.FutureTask[string]) {
+			go func() {
+				fmt.Println("Unwinding underlying resource before cancelling...")
+				time.Sleep(500 * time.Millisecond)
+				f.ForceCancel(f.PendingCause())
+			}()
+		}),
+	)
+	fmt.Println("State before cancel:", futureInstance7.State())
+	futureInstance7.Cancel()
+	fmt.Println(futureInstance7.Get(ctx))
+	fmt.Println("State after cancel:", futureInstance7.State())
+	fmt.Println("------------")
+
+	//example of a panicking task surfacing as ErrTaskPanic instead of crashing
+	futureInstance8 := future.ReturnAFuture(func() (string, error) {
+		panic("boom")
+	})
+	result8 := futureInstance8.Get(ctx)
+	fmt.Println("Recovered from panic:", errors.Is(result8.Error, future.ErrTaskPanic))
+	fmt.Println("------------")
 }
@@ -0,0 +1,155 @@
+// Package pipeline chains processing stages together on top of the future
+// package, wiring each stage's output channel into the next stage's input
+// and collecting the final results into a single Future.
+package pipeline
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ShashantNagpure/futureInGolang/future"
+)
+
+// Stage transforms a stream of I values into a stream of O values, reporting
+// per-item failures on its own error channel. Implementations must honor
+// ctx.Done() on every send and receive so the pipeline can shut down cleanly
+// on cancellation instead of leaving goroutines blocked forever.
+type Stage[I, O any] func(ctx context.Context, in <-chan I) (<-chan O, <-chan error)
+
+// Pipeline is an immutable chain of stages from an I source to an O result.
+// Use New to start a chain and Add to append stages to it.
+type Pipeline[I, O any] struct {
+	run func(ctx context.Context, in <-chan I) (<-chan O, <-chan error)
+}
+
+// New starts an empty pipeline that passes its source straight through.
+func New[I any]() *Pipeline[I, I] {
+	return &Pipeline[I, I]{
+		run: func(ctx context.Context, in <-chan I) (<-chan I, <-chan error) {
+			closedErrs := make(chan error)
+			close(closedErrs)
+			return in, closedErrs
+		},
+	}
+}
+
+// Add appends stage to p, producing a pipeline that runs p's chain first and
+// feeds its output into stage. It is a free function rather than a method
+// because Go methods cannot introduce the new type parameter N that a
+// differently-typed stage requires.
+func Add[I, O, N any](p *Pipeline[I, O], stage Stage[O, N]) *Pipeline[I, N] {
+	return &Pipeline[I, N]{
+		run: func(ctx context.Context, in <-chan I) (<-chan N, <-chan error) {
+			mid, errsFromPrev := p.run(ctx, in)
+			out, errsFromStage := stage(ctx, mid)
+			return out, mergeErrors(ctx, errsFromPrev, errsFromStage)
+		},
+	}
+}
+
+// Run starts the whole chain against source and returns a Future that
+// resolves to every O value produced, in completion order. Cancelling the
+// returned future propagates ctx cancellation to every stage, so their
+// goroutines observe it and drain instead of leaking.
+func (p *Pipeline[I, O]) Run(ctx context.Context, source <-chan I) *future.FutureTask[[]O] {
+	return future.NewFutureWithContext(ctx, func(taskCtx context.Context) future.Result[[]O] {
+		out, errs := p.run(taskCtx, source)
+		var collected []O
+		var firstErr error
+		for out != nil || errs != nil {
+			select {
+			case value, ok := <-out:
+				if !ok {
+					out = nil
+					continue
+				}
+				collected = append(collected, value)
+			case err, ok := <-errs:
+				if !ok {
+					errs = nil
+					continue
+				}
+				if firstErr == nil {
+					firstErr = err
+				}
+			case <-taskCtx.Done():
+				return future.Result[[]O]{Error: context.Cause(taskCtx)}
+			}
+		}
+		if firstErr != nil {
+			return future.Result[[]O]{Error: firstErr}
+		}
+		return future.Result[[]O]{Value: collected}
+	})
+}
+
+// mergeErrors fans two error channels into one, closing it once both inputs
+// are drained or ctx is cancelled.
+func mergeErrors(ctx context.Context, a, b <-chan error) <-chan error {
+	out := make(chan error)
+	go func() {
+		defer close(out)
+		var wg sync.WaitGroup
+		wg.Add(2)
+		forward := func(c <-chan error) {
+			defer wg.Done()
+			for {
+				select {
+				case err, ok := <-c:
+					if !ok {
+						return
+					}
+					select {
+					case out <- err:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+		go forward(a)
+		go forward(b)
+		wg.Wait()
+	}()
+	return out
+}
+
+// Lift adapts a per-item transform into a Stage, handling the ctx.Done()
+// bookkeeping so callers don't have to write the select boilerplate by hand.
+func Lift[I, O any](fn func(I) (O, error)) Stage[I, O] {
+	return func(ctx context.Context, in <-chan I) (<-chan O, <-chan error) {
+		out := make(chan O)
+		errs := make(chan error)
+		go func() {
+			defer close(out)
+			defer close(errs)
+			for {
+				select {
+				case value, ok := <-in:
+					if !ok {
+						return
+					}
+					result, err := fn(value)
+					if err != nil {
+						select {
+						case errs <- err:
+						case <-ctx.Done():
+							return
+						}
+						continue
+					}
+					select {
+					case out <- result:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return out, errs
+	}
+}
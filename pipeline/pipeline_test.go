@@ -0,0 +1,94 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPipelineRunCollectsTransformedValues(t *testing.T) {
+	square := Lift(func(n int) (int, error) {
+		return n * n, nil
+	})
+	format := Lift(func(n int) (string, error) {
+		return string(rune('a' + n%26)), nil
+	})
+
+	in := make(chan int, 3)
+	in <- 1
+	in <- 2
+	in <- 3
+	close(in)
+
+	chain := Add(Add(New[int](), square), format)
+	result := chain.Run(context.Background(), in).Get(context.Background())
+	if result.Error != nil {
+		t.Fatalf("unexpected error: %v", result.Error)
+	}
+	if len(result.Value) != 3 {
+		t.Fatalf("expected 3 values, got %d: %v", len(result.Value), result.Value)
+	}
+}
+
+func TestPipelineRunReportsStageError(t *testing.T) {
+	errBoom := errors.New("boom")
+	failsOnTwo := Lift(func(n int) (int, error) {
+		if n == 2 {
+			return 0, errBoom
+		}
+		return n, nil
+	})
+
+	in := make(chan int, 3)
+	in <- 1
+	in <- 2
+	in <- 3
+	close(in)
+
+	result := Add(New[int](), failsOnTwo).Run(context.Background(), in).Get(context.Background())
+	if !errors.Is(result.Error, errBoom) {
+		t.Fatalf("expected errBoom, got %v", result.Error)
+	}
+}
+
+func TestPipelineRunStopsOnCancellation(t *testing.T) {
+	blocked := Lift(func(n int) (int, error) {
+		<-make(chan struct{}) // never returns on its own
+		return n, nil
+	})
+
+	in := make(chan int, 1)
+	in <- 1
+
+	ctx, cancel := context.WithCancel(context.Background())
+	future := Add(New[int](), blocked).Run(ctx, in)
+	cancel()
+
+	result := future.Get(context.Background())
+	if !errors.Is(result.Error, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", result.Error)
+	}
+}
+
+func TestMergeErrorsFansInBothChannels(t *testing.T) {
+	a := make(chan error, 1)
+	b := make(chan error, 1)
+	errA := errors.New("a")
+	errB := errors.New("b")
+	a <- errA
+	close(a)
+	b <- errB
+	close(b)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	seen := map[error]bool{}
+	for err := range mergeErrors(ctx, a, b) {
+		seen[err] = true
+	}
+	if !seen[errA] || !seen[errB] {
+		t.Fatalf("expected both errors forwarded, got %v", seen)
+	}
+}